@@ -1,34 +1,438 @@
 package Cache
 
-import "time"
+import (
+	"container/heap"
+	"container/list"
+	"sync"
+	"time"
+)
+
+// entry is a single cached value plus the bookkeeping needed to keep the
+// expiry heap and priority buckets in sync with it.
+type entry[K comparable, V any] struct {
+	key      K
+	value    V
+	priority int
+	expire   time.Time
+	expIndex int // index of this entry in the expiry heap
+	elem     *list.Element
+}
+
+// expiryHeap is a min-heap of entries ordered by expire, giving O(log n)
+// access to the soonest-to-expire item.
+type expiryHeap[K comparable, V any] []*entry[K, V]
+
+func (h expiryHeap[K, V]) Len() int           { return len(h) }
+func (h expiryHeap[K, V]) Less(i, j int) bool { return h[i].expire.Before(h[j].expire) }
+func (h expiryHeap[K, V]) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].expIndex = i
+	h[j].expIndex = j
+}
+
+func (h *expiryHeap[K, V]) Push(x interface{}) {
+	e := x.(*entry[K, V])
+	e.expIndex = len(*h)
+	*h = append(*h, e)
+}
+
+func (h *expiryHeap[K, V]) Pop() interface{} {
+	old := *h
+	n := len(old)
+	e := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return e
+}
+
+// priorityLevel holds every live entry at a given priority, in LRU order
+// (front is least-recently-used, back is most-recently-used).
+type priorityLevel[K comparable, V any] struct {
+	priority int
+	items    *list.List
+	index    int // index of this level in the priority heap
+}
+
+// priorityHeap is a min-heap of priority levels, giving O(log p) access to
+// the lowest priority level that currently has entries in it.
+type priorityHeap[K comparable, V any] []*priorityLevel[K, V]
+
+func (h priorityHeap[K, V]) Len() int           { return len(h) }
+func (h priorityHeap[K, V]) Less(i, j int) bool { return h[i].priority < h[j].priority }
+func (h priorityHeap[K, V]) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *priorityHeap[K, V]) Push(x interface{}) {
+	l := x.(*priorityLevel[K, V])
+	l.index = len(*h)
+	*h = append(*h, l)
+}
+
+func (h *priorityHeap[K, V]) Pop() interface{} {
+	old := *h
+	n := len(old)
+	l := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return l
+}
+
+// EvictionReason identifies why an entry left the cache, passed to the
+// callback registered via SetOnEvicted.
+type EvictionReason int
+
+const (
+	// EvictionReasonExpired means the entry's expire time had passed.
+	EvictionReasonExpired EvictionReason = iota
+	// EvictionReasonPriority means the entry was the least-recently-used
+	// entry at the lowest priority level, evicted to make room.
+	EvictionReasonPriority
+	// EvictionReasonDeleted means the entry was removed by an explicit
+	// call to Delete.
+	EvictionReasonDeleted
+)
+
+func (r EvictionReason) String() string {
+	switch r {
+	case EvictionReasonExpired:
+		return "expired"
+	case EvictionReasonPriority:
+		return "priority"
+	case EvictionReasonDeleted:
+		return "deleted"
+	default:
+		return "unknown"
+	}
+}
+
+// Stats is a point-in-time snapshot of a cache's activity, returned by
+// Stats().
+type Stats struct {
+	Hits              int
+	Misses            int
+	Evictions         int
+	ExpiredEvictions  int
+	PriorityEvictions int
+	Size              int
+}
+
+// PriorityExpiryCache is safe for concurrent use by multiple goroutines.
+type PriorityExpiryCache[K comparable, V any] struct {
+	mu sync.Mutex
 
-type PriorityExpiryCache struct {
 	maxItems int
-	// TODO(interviewee): implement this
+
+	items     map[K]*entry[K, V]
+	expiry    expiryHeap[K, V]
+	levels    map[int]*priorityLevel[K, V]
+	levelHeap priorityHeap[K, V]
+
+	janitorDone chan struct{}
+
+	onEvicted func(key K, value V, reason EvictionReason)
+
+	hits, misses                        int
+	expiredEvictions, priorityEvictions int
 }
 
-func NewCache(maxItems int) *PriorityExpiryCache {
-	return &PriorityExpiryCache{
+func NewCache[K comparable, V any](maxItems int) *PriorityExpiryCache[K, V] {
+	return &PriorityExpiryCache[K, V]{
 		maxItems: maxItems,
+		items:    make(map[K]*entry[K, V]),
+		levels:   make(map[int]*priorityLevel[K, V]),
+	}
+}
+
+// Get returns the value stored for key, and marks it as the most recently
+// used entry within its priority level. A key past its expire is treated
+// as a miss and deleted, the same as Peek.
+func (c *PriorityExpiryCache[K, V]) Get(key K) V {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var zero V
+
+	e, ok := c.items[key]
+	if !ok {
+		c.misses++
+		return zero
+	}
+
+	if e.expire.Before(time.Now()) {
+		c.misses++
+		c.removeEntry(e, EvictionReasonExpired)
+		return zero
+	}
+
+	c.hits++
+	c.levels[e.priority].items.MoveToBack(e.elem)
+
+	return e.value
+}
+
+// Peek returns the value stored for key without updating its LRU position
+// within its priority level. It still triggers passive expiry: a key past
+// its expire is deleted and reported as not found.
+func (c *PriorityExpiryCache[K, V]) Peek(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var zero V
+
+	e, ok := c.items[key]
+	if !ok {
+		return zero, false
+	}
+
+	if e.expire.Before(time.Now()) {
+		c.removeEntry(e, EvictionReasonExpired)
+		return zero, false
+	}
+
+	return e.value, true
+}
+
+// Delete removes key from the cache, reporting whether it was present.
+func (c *PriorityExpiryCache[K, V]) Delete(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.items[key]
+	if !ok {
+		return false
 	}
+
+	c.removeEntry(e, EvictionReasonDeleted)
+	return true
 }
 
-func (c *PriorityExpiryCache) Get(key string) interface{} {
-	return nil
+// Len returns the number of entries currently in the cache.
+func (c *PriorityExpiryCache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.items)
 }
 
-func (c *PriorityExpiryCache) Set(key string, value interface{}, priority int, expire time.Time) {
-	// ... the interviewee does not need to implement this.
+// Keys returns a snapshot of every key currently in the cache, in no
+// particular order.
+func (c *PriorityExpiryCache[K, V]) Keys() []K {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	keys := make([]K, 0, len(c.items))
+	for k := range c.items {
+		keys = append(keys, k)
+	}
+
+	return keys
+}
+
+// Each calls fn for every entry in the cache, in no particular order,
+// stopping early if fn returns false. fn is called with the cache's lock
+// held, so it must not call back into the cache.
+func (c *PriorityExpiryCache[K, V]) Each(fn func(key K, value V) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for k, e := range c.items {
+		if !fn(k, e.value) {
+			return
+		}
+	}
+}
+
+// SetOnEvicted registers a callback invoked whenever an entry leaves the
+// cache, whether through eviction or explicit deletion. fn is called with
+// the cache's lock held, so it must not call back into the cache.
+func (c *PriorityExpiryCache[K, V]) SetOnEvicted(fn func(key K, value V, reason EvictionReason)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.onEvicted = fn
+}
+
+// Stats returns a snapshot of the cache's hit/miss/eviction counters.
+func (c *PriorityExpiryCache[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return Stats{
+		Hits:              c.hits,
+		Misses:            c.misses,
+		Evictions:         c.expiredEvictions + c.priorityEvictions,
+		ExpiredEvictions:  c.expiredEvictions,
+		PriorityEvictions: c.priorityEvictions,
+		Size:              len(c.items),
+	}
+}
+
+func (c *PriorityExpiryCache[K, V]) Set(key K, value V, priority int, expire time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.items[key]; ok {
+		e.value = value
+		e.expire = expire
+		heap.Fix(&c.expiry, e.expIndex)
+
+		if e.priority != priority {
+			c.removeFromLevel(e)
+			e.priority = priority
+			e.elem = c.pushToLevel(priority, e)
+		} else {
+			c.levels[priority].items.MoveToBack(e.elem)
+		}
+
+		c.evictItems()
+		return
+	}
+
+	e := &entry[K, V]{key: key, value: value, priority: priority, expire: expire}
+	heap.Push(&c.expiry, e)
+	e.elem = c.pushToLevel(priority, e)
+	c.items[key] = e
 
 	c.evictItems()
 }
 
-func (c *PriorityExpiryCache) SetMaxItems(maxItems int) {
+func (c *PriorityExpiryCache[K, V]) SetMaxItems(maxItems int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	c.maxItems = maxItems
 
 	c.evictItems()
 }
 
+// pushToLevel appends e to the back of its priority level, creating the
+// level (and registering it in the priority heap) if this is the first
+// entry at that priority.
+func (c *PriorityExpiryCache[K, V]) pushToLevel(priority int, e *entry[K, V]) *list.Element {
+	l, ok := c.levels[priority]
+	if !ok {
+		l = &priorityLevel[K, V]{priority: priority, items: list.New()}
+		c.levels[priority] = l
+		heap.Push(&c.levelHeap, l)
+	}
+
+	return l.items.PushBack(e)
+}
+
+// removeFromLevel detaches e from its priority level, tearing the level
+// down entirely once it has no entries left.
+func (c *PriorityExpiryCache[K, V]) removeFromLevel(e *entry[K, V]) {
+	l := c.levels[e.priority]
+	l.items.Remove(e.elem)
+	e.elem = nil
+
+	if l.items.Len() == 0 {
+		heap.Remove(&c.levelHeap, l.index)
+		delete(c.levels, e.priority)
+	}
+}
+
+// removeEntry deletes e from every index the cache maintains, recording
+// reason in the stats counters and notifying OnEvicted if set.
+func (c *PriorityExpiryCache[K, V]) removeEntry(e *entry[K, V], reason EvictionReason) {
+	heap.Remove(&c.expiry, e.expIndex)
+	c.removeFromLevel(e)
+	delete(c.items, e.key)
+
+	switch reason {
+	case EvictionReasonExpired:
+		c.expiredEvictions++
+	case EvictionReasonPriority:
+		c.priorityEvictions++
+	}
+
+	if c.onEvicted != nil {
+		c.onEvicted(e.key, e.value, reason)
+	}
+}
+
+// StartJanitor spawns a goroutine that periodically scans the cache for
+// expired entries and deletes them, independent of Set/Get traffic. It is
+// idempotent: calling it again while a janitor is already running is a
+// no-op. Callers that start a janitor must call StopJanitor once they are
+// done with the cache, or the goroutine leaks.
+func (c *PriorityExpiryCache[K, V]) StartJanitor(interval time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.janitorDone != nil {
+		return
+	}
+
+	done := make(chan struct{})
+	c.janitorDone = done
+	go c.runJanitor(interval, done)
+}
+
+func (c *PriorityExpiryCache[K, V]) runJanitor(interval time.Duration, done chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			c.deleteExpired()
+		case <-done:
+			return
+		}
+	}
+}
+
+// StopJanitor stops a janitor goroutine started by StartJanitor. It is
+// safe to call multiple times, and a no-op if no janitor is running.
+func (c *PriorityExpiryCache[K, V]) StopJanitor() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.janitorDone == nil {
+		return
+	}
+
+	close(c.janitorDone)
+	c.janitorDone = nil
+}
+
+// deleteExpired removes every entry whose expire has already passed.
+func (c *PriorityExpiryCache[K, V]) deleteExpired() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+
+	for len(c.expiry) > 0 && c.expiry[0].expire.Before(now) {
+		c.removeEntry(c.expiry[0], EvictionReasonExpired)
+	}
+}
+
 // evictItems will evict items from the cache to make room for new ones.
-func (c *PriorityExpiryCache) evictItems() {
+// Expired items always go first; once none are expired, it falls back to
+// the least-recently-used entry at the lowest priority level.
+func (c *PriorityExpiryCache[K, V]) evictItems() {
+	now := time.Now()
+
+	for len(c.items) > c.maxItems {
+		if len(c.expiry) > 0 && c.expiry[0].expire.Before(now) {
+			c.removeEntry(c.expiry[0], EvictionReasonExpired)
+			continue
+		}
+
+		if len(c.levelHeap) == 0 {
+			return
+		}
+
+		front := c.levelHeap[0].items.Front()
+		if front == nil {
+			return
+		}
+
+		c.removeEntry(front.Value.(*entry[K, V]), EvictionReasonPriority)
+	}
 }
@@ -0,0 +1,250 @@
+package Cache
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+// This mirrors the classic Interview-Cake prompt: a cache bounded by item
+// count that needs to evict expired items first, then fall back to the
+// lowest priority level, breaking ties by least-recently-used.
+
+func TestEvictsExpiredBeforeLowerPriority(t *testing.T) {
+	c := NewCache[string, int](2)
+
+	c.Set("stale", 1, 10, time.Now().Add(-time.Minute)) // already expired, high priority
+	c.Set("low", 2, 1, time.Now().Add(time.Hour))
+
+	c.Set("new", 3, 1, time.Now().Add(time.Hour))
+
+	if v := c.Get("stale"); v != 0 {
+		t.Fatalf("expected expired entry to be evicted first, got %d", v)
+	}
+	if v := c.Get("low"); v != 2 {
+		t.Fatalf("expected unrelated low priority entry to survive, got %d", v)
+	}
+	if v := c.Get("new"); v != 3 {
+		t.Fatalf("expected newly set entry to survive, got %d", v)
+	}
+}
+
+func TestEvictsLowestPriorityThenLRU(t *testing.T) {
+	c := NewCache[string, int](3)
+
+	c.Set("a", 1, 5, time.Now().Add(time.Hour))
+	c.Set("b", 2, 1, time.Now().Add(time.Hour))
+	c.Set("c", 3, 1, time.Now().Add(time.Hour))
+
+	// touch "b" so "c" becomes the least-recently-used entry at priority 1
+	c.Get("b")
+
+	c.Set("d", 4, 5, time.Now().Add(time.Hour))
+
+	if v := c.Get("c"); v != 0 {
+		t.Fatalf("expected LRU entry at lowest priority to be evicted, got %d", v)
+	}
+	if v := c.Get("a"); v != 1 {
+		t.Fatalf("expected higher priority entry to survive, got %d", v)
+	}
+	if v := c.Get("b"); v != 2 {
+		t.Fatalf("expected recently used entry to survive, got %d", v)
+	}
+	if v := c.Get("d"); v != 4 {
+		t.Fatalf("expected newly set entry to survive, got %d", v)
+	}
+}
+
+func TestSetMaxItemsShrinksCache(t *testing.T) {
+	c := NewCache[string, int](5)
+
+	c.Set("a", 1, 1, time.Now().Add(time.Hour))
+	c.Set("b", 2, 2, time.Now().Add(time.Hour))
+	c.Set("c", 3, 3, time.Now().Add(time.Hour))
+
+	c.SetMaxItems(1)
+
+	if v := c.Get("a"); v != 0 {
+		t.Fatalf("expected lowest priority entry to be evicted after shrink, got %d", v)
+	}
+	if v := c.Get("b"); v != 0 {
+		t.Fatalf("expected second lowest priority entry to be evicted after shrink, got %d", v)
+	}
+	if v := c.Get("c"); v != 3 {
+		t.Fatalf("expected highest priority entry to survive shrink, got %d", v)
+	}
+}
+
+func TestJanitorExpiresEntriesWithoutSet(t *testing.T) {
+	c := NewCache[string, int](10)
+	c.Set("a", 1, 1, time.Now().Add(20*time.Millisecond))
+
+	c.StartJanitor(5 * time.Millisecond)
+	defer c.StopJanitor()
+
+	deadline := time.Now().Add(time.Second)
+	for c.Len() != 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("expected janitor to expire the entry without any Set/Get calls")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestStopJanitorIsIdempotent(t *testing.T) {
+	c := NewCache[string, int](10)
+	c.StartJanitor(time.Millisecond)
+	c.StopJanitor()
+	c.StopJanitor()
+}
+
+func TestStatsAndOnEvicted(t *testing.T) {
+	c := NewCache[string, int](1)
+
+	var evicted []string
+	c.SetOnEvicted(func(key string, value int, reason EvictionReason) {
+		if reason != EvictionReasonPriority {
+			t.Fatalf("expected priority eviction, got %v", reason)
+		}
+		evicted = append(evicted, key)
+	})
+
+	c.Set("a", 1, 1, time.Now().Add(time.Hour))
+	c.Set("b", 2, 1, time.Now().Add(time.Hour))
+
+	c.Get("b")
+	c.Get("missing")
+
+	stats := c.Stats()
+	if stats.Size != 1 {
+		t.Fatalf("expected size 1, got %d", stats.Size)
+	}
+	if stats.PriorityEvictions != 1 || stats.Evictions != 1 {
+		t.Fatalf("expected one priority eviction, got %+v", stats)
+	}
+	if stats.Hits != 1 || stats.Misses != 1 {
+		t.Fatalf("expected one hit and one miss, got %+v", stats)
+	}
+	if len(evicted) != 1 || evicted[0] != "a" {
+		t.Fatalf("expected OnEvicted to fire for %q, got %v", "a", evicted)
+	}
+}
+
+func TestPeekDoesNotUpdateLRU(t *testing.T) {
+	c := NewCache[string, int](2)
+
+	c.Set("a", 1, 1, time.Now().Add(time.Hour))
+	c.Set("b", 2, 1, time.Now().Add(time.Hour))
+
+	if v, ok := c.Peek("a"); !ok || v != 1 {
+		t.Fatalf("expected to peek value 1, got %d, %v", v, ok)
+	}
+
+	// "a" should still be the LRU entry at priority 1, since Peek must not
+	// have promoted it.
+	c.Set("c", 3, 1, time.Now().Add(time.Hour))
+
+	if _, ok := c.Peek("a"); ok {
+		t.Fatal("expected \"a\" to have been evicted as the LRU entry")
+	}
+}
+
+func TestPeekExpiresPassively(t *testing.T) {
+	c := NewCache[string, int](10)
+	c.Set("a", 1, 1, time.Now().Add(-time.Minute))
+
+	if _, ok := c.Peek("a"); ok {
+		t.Fatal("expected Peek on an expired key to report not found")
+	}
+	if c.Len() != 0 {
+		t.Fatalf("expected Peek to delete the expired entry, len=%d", c.Len())
+	}
+}
+
+func TestDeleteKeysLenEach(t *testing.T) {
+	c := NewCache[string, int](10)
+	c.Set("a", 1, 1, time.Now().Add(time.Hour))
+	c.Set("b", 2, 2, time.Now().Add(time.Hour))
+
+	var reason EvictionReason
+	c.SetOnEvicted(func(key string, value int, r EvictionReason) { reason = r })
+
+	if ok := c.Delete("a"); !ok {
+		t.Fatal("expected Delete to report the key was present")
+	}
+	if ok := c.Delete("a"); ok {
+		t.Fatal("expected Delete to report the key was already gone")
+	}
+	if reason != EvictionReasonDeleted {
+		t.Fatalf("expected EvictionReasonDeleted, got %v", reason)
+	}
+
+	if c.Len() != 1 {
+		t.Fatalf("expected len 1, got %d", c.Len())
+	}
+
+	keys := c.Keys()
+	if len(keys) != 1 || keys[0] != "b" {
+		t.Fatalf("expected keys [b], got %v", keys)
+	}
+
+	seen := map[string]int{}
+	c.Each(func(key string, value int) bool {
+		seen[key] = value
+		return true
+	})
+	if len(seen) != 1 || seen["b"] != 2 {
+		t.Fatalf("expected Each to visit b=2, got %v", seen)
+	}
+}
+
+func TestGetExpiresPassively(t *testing.T) {
+	c := NewCache[string, int](10)
+	c.Set("a", 42, 1, time.Now().Add(-time.Minute))
+
+	if v := c.Get("a"); v != 0 {
+		t.Fatalf("expected Get on an expired key to return zero value, got %d", v)
+	}
+	if c.Len() != 0 {
+		t.Fatalf("expected Get to delete the expired entry, len=%d", c.Len())
+	}
+
+	stats := c.Stats()
+	if stats.Hits != 0 || stats.Misses != 1 {
+		t.Fatalf("expected an expired Get to count as a miss, got %+v", stats)
+	}
+}
+
+func TestConcurrentAccess(t *testing.T) {
+	c := NewCache[string, int](50)
+
+	const goroutines = 8
+	const opsPerGoroutine = 200
+
+	var wg sync.WaitGroup
+	wg.Add(goroutines)
+	for g := 0; g < goroutines; g++ {
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < opsPerGoroutine; i++ {
+				key := fmt.Sprintf("key-%d", (g*opsPerGoroutine+i)%20)
+				switch i % 5 {
+				case 0:
+					c.Set(key, i, i%3, time.Now().Add(time.Hour))
+				case 1:
+					c.Get(key)
+				case 2:
+					c.Peek(key)
+				case 3:
+					c.Delete(key)
+				default:
+					c.Len()
+					c.Keys()
+					c.Stats()
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,164 @@
+package Cache
+
+import (
+	"runtime"
+	"time"
+)
+
+// fnv1a64 is a standalone, allocation-free implementation of the FNV-1a
+// hash, used to pick a shard for a key without going through hash.Hash64.
+func fnv1a64(key string) uint64 {
+	const (
+		offset64 = 14695981039346656037
+		prime64  = 1099511628211
+	)
+
+	h := uint64(offset64)
+	for i := 0; i < len(key); i++ {
+		h ^= uint64(key[i])
+		h *= prime64
+	}
+
+	return h
+}
+
+// ShardedPriorityExpiryCache fans keys across several independent
+// PriorityExpiryCache shards to reduce lock contention under concurrent
+// use. Sharding requires hashing the key, so unlike PriorityExpiryCache[K,
+// V] it is specialized to string keys.
+type ShardedPriorityExpiryCache[V any] struct {
+	shards []*PriorityExpiryCache[string, V]
+}
+
+// NewShardedCache creates a sharded cache holding roughly maxItems entries
+// in total, spread evenly across shards shards. A shards value <= 0 uses
+// runtime.GOMAXPROCS(0).
+func NewShardedCache[V any](maxItems int, shards int) *ShardedPriorityExpiryCache[V] {
+	if shards <= 0 {
+		shards = runtime.GOMAXPROCS(0)
+	}
+
+	s := &ShardedPriorityExpiryCache[V]{
+		shards: make([]*PriorityExpiryCache[string, V], shards),
+	}
+	for i := range s.shards {
+		s.shards[i] = NewCache[string, V](perShardMaxItems(maxItems, shards))
+	}
+
+	return s
+}
+
+func perShardMaxItems(maxItems, shards int) int {
+	perShard := maxItems / shards
+	if perShard < 1 {
+		perShard = 1
+	}
+
+	return perShard
+}
+
+func (s *ShardedPriorityExpiryCache[V]) shardFor(key string) *PriorityExpiryCache[string, V] {
+	return s.shards[fnv1a64(key)%uint64(len(s.shards))]
+}
+
+func (s *ShardedPriorityExpiryCache[V]) Get(key string) V {
+	return s.shardFor(key).Get(key)
+}
+
+func (s *ShardedPriorityExpiryCache[V]) Set(key string, value V, priority int, expire time.Time) {
+	s.shardFor(key).Set(key, value, priority, expire)
+}
+
+// Peek returns the value stored for key without updating its LRU position.
+func (s *ShardedPriorityExpiryCache[V]) Peek(key string) (V, bool) {
+	return s.shardFor(key).Peek(key)
+}
+
+// Delete removes key from the cache, reporting whether it was present.
+func (s *ShardedPriorityExpiryCache[V]) Delete(key string) bool {
+	return s.shardFor(key).Delete(key)
+}
+
+// SetMaxItems redistributes maxItems evenly across the shards.
+func (s *ShardedPriorityExpiryCache[V]) SetMaxItems(maxItems int) {
+	perShard := perShardMaxItems(maxItems, len(s.shards))
+	for _, shard := range s.shards {
+		shard.SetMaxItems(perShard)
+	}
+}
+
+// SetOnEvicted registers fn on every shard.
+func (s *ShardedPriorityExpiryCache[V]) SetOnEvicted(fn func(key string, value V, reason EvictionReason)) {
+	for _, shard := range s.shards {
+		shard.SetOnEvicted(fn)
+	}
+}
+
+// StartJanitor starts a janitor goroutine on every shard.
+func (s *ShardedPriorityExpiryCache[V]) StartJanitor(interval time.Duration) {
+	for _, shard := range s.shards {
+		shard.StartJanitor(interval)
+	}
+}
+
+// StopJanitor stops the janitor goroutine on every shard.
+func (s *ShardedPriorityExpiryCache[V]) StopJanitor() {
+	for _, shard := range s.shards {
+		shard.StopJanitor()
+	}
+}
+
+// Len returns the total number of entries across all shards.
+func (s *ShardedPriorityExpiryCache[V]) Len() int {
+	total := 0
+	for _, shard := range s.shards {
+		total += shard.Len()
+	}
+
+	return total
+}
+
+// Keys returns a snapshot of every key across all shards, in no particular
+// order.
+func (s *ShardedPriorityExpiryCache[V]) Keys() []string {
+	var keys []string
+	for _, shard := range s.shards {
+		keys = append(keys, shard.Keys()...)
+	}
+
+	return keys
+}
+
+// Each calls fn for every entry across all shards, stopping early if fn
+// returns false.
+func (s *ShardedPriorityExpiryCache[V]) Each(fn func(key string, value V) bool) {
+	for _, shard := range s.shards {
+		stop := false
+		shard.Each(func(key string, value V) bool {
+			if !fn(key, value) {
+				stop = true
+				return false
+			}
+			return true
+		})
+		if stop {
+			return
+		}
+	}
+}
+
+// Stats aggregates the hit/miss/eviction counters across all shards.
+func (s *ShardedPriorityExpiryCache[V]) Stats() Stats {
+	var total Stats
+	for _, shard := range s.shards {
+		st := shard.Stats()
+		total.Hits += st.Hits
+		total.Misses += st.Misses
+		total.Evictions += st.Evictions
+		total.ExpiredEvictions += st.ExpiredEvictions
+		total.PriorityEvictions += st.PriorityEvictions
+		total.Size += st.Size
+	}
+
+	return total
+}
@@ -0,0 +1,206 @@
+package Cache
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestShardedCacheDistributesAndAggregates(t *testing.T) {
+	s := NewShardedCache[int](100, 4)
+
+	for i := 0; i < 20; i++ {
+		s.Set(fmt.Sprintf("key-%d", i), i, 1, time.Now().Add(time.Hour))
+	}
+
+	if s.Len() != 20 {
+		t.Fatalf("expected 20 entries across shards, got %d", s.Len())
+	}
+
+	for i := 0; i < 20; i++ {
+		key := fmt.Sprintf("key-%d", i)
+		if v, ok := s.Peek(key); !ok || v != i {
+			t.Fatalf("expected %s=%d, got %d, %v", key, i, v, ok)
+		}
+	}
+
+	if len(s.Keys()) != 20 {
+		t.Fatalf("expected 20 keys, got %d", len(s.Keys()))
+	}
+
+	if ok := s.Delete("key-0"); !ok {
+		t.Fatal("expected key-0 to be deleted")
+	}
+	if s.Len() != 19 {
+		t.Fatalf("expected 19 entries after delete, got %d", s.Len())
+	}
+}
+
+func TestShardedCacheSetMaxItemsRedistributes(t *testing.T) {
+	s := NewShardedCache[int](100, 4)
+	s.SetMaxItems(8)
+
+	for _, shard := range s.shards {
+		if shard.maxItems != 2 {
+			t.Fatalf("expected each shard to hold 2 items, got %d", shard.maxItems)
+		}
+	}
+}
+
+func TestShardedCacheEach(t *testing.T) {
+	s := NewShardedCache[int](100, 4)
+	for i := 0; i < 10; i++ {
+		s.Set(fmt.Sprintf("key-%d", i), i, 1, time.Now().Add(time.Hour))
+	}
+
+	seen := map[string]int{}
+	s.Each(func(key string, value int) bool {
+		seen[key] = value
+		return true
+	})
+	if len(seen) != 10 {
+		t.Fatalf("expected Each to visit all 10 entries across shards, got %d", len(seen))
+	}
+
+	count := 0
+	s.Each(func(key string, value int) bool {
+		count++
+		return false
+	})
+	if count != 1 {
+		t.Fatalf("expected Each to stop after the first false, visited %d", count)
+	}
+}
+
+func TestShardedCacheStats(t *testing.T) {
+	s := NewShardedCache[int](100, 4)
+
+	for i := 0; i < 10; i++ {
+		s.Set(fmt.Sprintf("key-%d", i), i, 1, time.Now().Add(time.Hour))
+	}
+	for i := 0; i < 10; i++ {
+		s.Get(fmt.Sprintf("key-%d", i))
+	}
+	s.Get("missing")
+
+	stats := s.Stats()
+	if stats.Size != 10 {
+		t.Fatalf("expected aggregated size 10, got %d", stats.Size)
+	}
+	if stats.Hits != 10 || stats.Misses != 1 {
+		t.Fatalf("expected aggregated hits=10 misses=1, got %+v", stats)
+	}
+}
+
+func TestShardedCacheSetOnEvicted(t *testing.T) {
+	s := NewShardedCache[int](4, 4) // maxItems=1 per shard
+
+	var mu sync.Mutex
+	evicted := map[string]EvictionReason{}
+	s.SetOnEvicted(func(key string, value int, reason EvictionReason) {
+		mu.Lock()
+		evicted[key] = reason
+		mu.Unlock()
+	})
+
+	s.Set("a", 1, 1, time.Now().Add(time.Hour))
+	s.Delete("a")
+
+	mu.Lock()
+	defer mu.Unlock()
+	if evicted["a"] != EvictionReasonDeleted {
+		t.Fatalf("expected OnEvicted to report key %q as deleted, got %v", "a", evicted)
+	}
+}
+
+func TestShardedCacheJanitorExpiresEntries(t *testing.T) {
+	s := NewShardedCache[int](100, 4)
+	s.Set("a", 1, 1, time.Now().Add(20*time.Millisecond))
+
+	s.StartJanitor(5 * time.Millisecond)
+	defer s.StopJanitor()
+
+	deadline := time.Now().Add(time.Second)
+	for s.Len() != 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("expected janitor to expire the entry on its shard without any Set/Get calls")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestConcurrentShardedAccess(t *testing.T) {
+	s := NewShardedCache[int](50, 4)
+
+	const goroutines = 8
+	const opsPerGoroutine = 200
+
+	var wg sync.WaitGroup
+	wg.Add(goroutines)
+	for g := 0; g < goroutines; g++ {
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < opsPerGoroutine; i++ {
+				key := fmt.Sprintf("key-%d", (g*opsPerGoroutine+i)%20)
+				switch i % 5 {
+				case 0:
+					s.Set(key, i, i%3, time.Now().Add(time.Hour))
+				case 1:
+					s.Get(key)
+				case 2:
+					s.Peek(key)
+				case 3:
+					s.Delete(key)
+				default:
+					s.Len()
+					s.Keys()
+					s.Stats()
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+}
+
+func BenchmarkShardedVsSingleLock(b *testing.B) {
+	const keys = 1000
+
+	b.Run("single-lock", func(b *testing.B) {
+		c := NewCache[string, int](keys)
+		benchmarkMixedWorkload(b, keys, func(key string, i int) {
+			c.Set(key, i, i%5, time.Now().Add(time.Hour))
+		}, func(key string) {
+			c.Get(key)
+		})
+	})
+
+	b.Run("sharded", func(b *testing.B) {
+		s := NewShardedCache[int](keys, 0)
+		benchmarkMixedWorkload(b, keys, func(key string, i int) {
+			s.Set(key, i, i%5, time.Now().Add(time.Hour))
+		}, func(key string) {
+			s.Get(key)
+		})
+	})
+}
+
+// benchmarkMixedWorkload runs an 80/20 read/write workload across
+// b.N operations, spread over GOMAXPROCS goroutines.
+func benchmarkMixedWorkload(b *testing.B, keys int, set func(key string, i int), get func(key string)) {
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		rng := rand.New(rand.NewSource(1))
+		i := 0
+		for pb.Next() {
+			key := fmt.Sprintf("key-%d", rng.Intn(keys))
+			if rng.Intn(100) < 20 {
+				set(key, i)
+			} else {
+				get(key)
+			}
+			i++
+		}
+	})
+}